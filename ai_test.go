@@ -0,0 +1,53 @@
+package main
+
+import "testing"
+
+// TestHardMoveTakesWinningMove checks that the AI takes an immediate win instead of
+// searching past it.
+func TestHardMoveTakesWinningMove(t *testing.T) {
+	board := newBoard(3, 3)
+	board[0][0] = X
+	board[0][1] = X
+	board[1][0] = O
+	board[1][1] = O
+
+	hardMove(board, 3, 3, 3, X)
+
+	if board[0][2] != X {
+		t.Errorf("hardMove did not take the winning move at (0,2); board=%v", board)
+	}
+}
+
+// TestHardMoveBlocksOpponentWin checks that the AI blocks an opponent's immediate win
+// when it has no win of its own available.
+func TestHardMoveBlocksOpponentWin(t *testing.T) {
+	board := newBoard(3, 3)
+	board[0][0] = O
+	board[0][1] = O
+	board[1][0] = X
+	board[2][2] = X
+
+	hardMove(board, 3, 3, 3, X)
+
+	if board[0][2] != X {
+		t.Errorf("hardMove did not block O's winning move at (0,2); board=%v", board)
+	}
+}
+
+// TestNegamaxPruningNodeCount guards against alpha-beta cutoffs only breaking the inner
+// loop: a cutoff that fails to bail out of the whole node visits far more positions than
+// one that does. This position searches 557 nodes with a full break; a regression to
+// inner-loop-only breaking raises it to 1392.
+func TestNegamaxPruningNodeCount(t *testing.T) {
+	board := newBoard(3, 3)
+	board[0][0] = X
+	board[1][1] = O
+
+	tt := make(map[string]int)
+	searchNodes = 0
+	negamax(board, 3, 3, 3, X, 9, -1000, 1000, tt)
+
+	if searchNodes > 900 {
+		t.Errorf("negamax visited %d nodes, want <= 900 (alpha-beta cutoff may not be pruning the whole node)", searchNodes)
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"io"
+	"log"
+
+	"github.com/hajimehoshi/ebiten/v2/audio"
+	"github.com/hajimehoshi/ebiten/v2/audio/wav"
+)
+
+// sampleRate must match the rate the shared audio context decodes and plays at.
+const sampleRate = 44100
+
+var (
+	audioContext *audio.Context
+	muted        bool
+
+	clickSound []byte
+	xWinsSound []byte
+	oWinsSound []byte
+	drawSound  []byte
+)
+
+// initAudio sets up the shared audio context and decodes every SFX asset into a PCM buffer.
+func initAudio() {
+	audioContext = audio.NewContext(sampleRate)
+
+	clickSound = loadSound("click.wav")
+	xWinsSound = loadSound("xwins.wav")
+	oWinsSound = loadSound("owins.wav")
+	drawSound = loadSound("draw.wav")
+}
+
+// loadSound decodes a wav asset from the embedded snd/ directory into raw PCM bytes.
+func loadSound(name string) []byte {
+	f, err := sounds.Open(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	stream, err := wav.DecodeWithSampleRate(sampleRate, f)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	data, err := io.ReadAll(stream)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return data
+}
+
+// playSound starts a short-lived player for data on the shared audio context, unless muted.
+func playSound(data []byte) {
+	if muted || len(data) == 0 {
+		return
+	}
+
+	player := audioContext.NewPlayerFromBytes(data)
+	player.Play()
+}
@@ -0,0 +1,97 @@
+package main
+
+// Cell is the contents of a single board square.
+type Cell int
+
+const (
+	Empty Cell = iota
+	X
+	O
+)
+
+// opponent returns the other player's mark.
+func (c Cell) opponent() Cell {
+	if c == X {
+		return O
+	}
+	return X
+}
+
+// PlayerType marks whether a side is driven by a human or the AI.
+type PlayerType int
+
+const (
+	HumanPlayer PlayerType = iota
+	AIPlayer
+)
+
+// BoardConfig describes an m,n,k-game: an m x n board where k in a row wins.
+type BoardConfig struct {
+	Name string
+	Rows int
+	Cols int
+	K    int
+}
+
+// boardPresets are offered on the title screen's board-selection step.
+var boardPresets = []BoardConfig{
+	{Name: "3x3, 3 in a row (classic)", Rows: 3, Cols: 3, K: 3},
+	{Name: "4x4, 3 in a row", Rows: 4, Cols: 4, K: 3},
+	{Name: "5x5, 4 in a row (gomoku-lite)", Rows: 5, Cols: 5, K: 4},
+}
+
+// newBoard allocates an empty rows x cols board.
+func newBoard(rows, cols int) [][]Cell {
+	board := make([][]Cell, rows)
+	for r := range board {
+		board[r] = make([]Cell, cols)
+	}
+	return board
+}
+
+// boardIsFull reports whether every cell is occupied.
+func boardIsFull(board [][]Cell) bool {
+	for _, row := range board {
+		for _, cell := range row {
+			if cell == Empty {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// lineDirections are the four directions a k-in-a-row can run: horizontal, vertical, and the two diagonals.
+var lineDirections = [4][2]int{{0, 1}, {1, 0}, {1, 1}, {1, -1}}
+
+// checkWinFor reports whether `mark` has k consecutive cells along any row, column, or diagonal.
+func checkWinFor(board [][]Cell, rows, cols, k int, mark Cell) bool {
+	for row := 0; row < rows; row++ {
+		for col := 0; col < cols; col++ {
+			if board[row][col] != mark {
+				continue
+			}
+			for _, dir := range lineDirections {
+				if lineWins(board, rows, cols, k, row, col, dir[0], dir[1], mark) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// lineWins checks whether k consecutive cells starting at (row, col) and stepping by (dr, dc) all equal mark.
+func lineWins(board [][]Cell, rows, cols, k, row, col, dr, dc int, mark Cell) bool {
+	endRow := row + dr*(k-1)
+	endCol := col + dc*(k-1)
+	if endRow < 0 || endRow >= rows || endCol < 0 || endCol >= cols {
+		return false
+	}
+	for i := 0; i < k; i++ {
+		if board[row+dr*i][col+dc*i] != mark {
+			return false
+		}
+	}
+	return true
+}
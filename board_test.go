@@ -0,0 +1,68 @@
+package main
+
+import "testing"
+
+func TestCheckWinFor(t *testing.T) {
+	tests := []struct {
+		name       string
+		rows, cols int
+		k          int
+		moves      [][2]int // cells set to X, in order
+		want       bool
+	}{
+		{
+			name: "no win on empty 3x3",
+			rows: 3, cols: 3, k: 3,
+			moves: nil,
+			want:  false,
+		},
+		{
+			name: "row win on 3x3",
+			rows: 3, cols: 3, k: 3,
+			moves: [][2]int{{0, 0}, {0, 1}, {0, 2}},
+			want:  true,
+		},
+		{
+			name: "column win on 3x3",
+			rows: 3, cols: 3, k: 3,
+			moves: [][2]int{{0, 0}, {1, 0}, {2, 0}},
+			want:  true,
+		},
+		{
+			name: "diagonal win on 3x3",
+			rows: 3, cols: 3, k: 3,
+			moves: [][2]int{{0, 0}, {1, 1}, {2, 2}},
+			want:  true,
+		},
+		{
+			name: "anti-diagonal win on 3x3",
+			rows: 3, cols: 3, k: 3,
+			moves: [][2]int{{0, 2}, {1, 1}, {2, 0}},
+			want:  true,
+		},
+		{
+			name: "three in a row is not enough when k is 4",
+			rows: 5, cols: 5, k: 4,
+			moves: [][2]int{{0, 0}, {0, 1}, {0, 2}},
+			want:  false,
+		},
+		{
+			name: "four in a row wins on 5x5 with k=4",
+			rows: 5, cols: 5, k: 4,
+			moves: [][2]int{{0, 0}, {0, 1}, {0, 2}, {0, 3}},
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			board := newBoard(tt.rows, tt.cols)
+			for _, move := range tt.moves {
+				board[move[0]][move[1]] = X
+			}
+			if got := checkWinFor(board, tt.rows, tt.cols, tt.k, X); got != tt.want {
+				t.Errorf("checkWinFor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// GameScene runs the play loop for a single match: clicks and AI moves alternate
+// until a win or draw, at which point it hands off to a ResultScene.
+type GameScene struct {
+	manager *SceneManager
+	stats   *Stats
+
+	preset BoardConfig
+	board  [][]Cell
+	cellW  int
+	cellH  int
+
+	currentTurn Cell
+	difficulty  Difficulty
+	players     map[Cell]PlayerType
+	aiDelay     int
+}
+
+// NewGameScene starts a new match. players assigns HumanPlayer/AIPlayer to X and O;
+// X always moves first, so if players[X] is AIPlayer the AI opens the game.
+func NewGameScene(manager *SceneManager, stats *Stats, preset BoardConfig, difficulty Difficulty, players map[Cell]PlayerType) *GameScene {
+	s := &GameScene{
+		manager:     manager,
+		stats:       stats,
+		preset:      preset,
+		board:       newBoard(preset.Rows, preset.Cols),
+		cellW:       ScreenWidth / preset.Cols,
+		cellH:       ScreenHeight / preset.Rows,
+		currentTurn: X,
+		difficulty:  difficulty,
+		players:     players,
+	}
+	if players[X] == AIPlayer {
+		s.aiDelay = 30 // Wait about half a second before AI moves
+	}
+	return s
+}
+
+func (s *GameScene) Update() error {
+	if inpututil.IsKeyJustPressed(ebiten.KeyM) {
+		muted = !muted
+	}
+
+	switch s.players[s.currentTurn] {
+	case HumanPlayer:
+		s.updateHumanTurn()
+	case AIPlayer:
+		s.updateAITurn()
+	}
+
+	return nil
+}
+
+// updateHumanTurn handles a mouse click for whichever mark is human-controlled.
+func (s *GameScene) updateHumanTurn() {
+	if !inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		return
+	}
+
+	x, y := ebiten.CursorPosition()
+	row := y / s.cellH
+	col := x / s.cellW
+
+	if row < 0 || row >= s.preset.Rows || col < 0 || col >= s.preset.Cols || s.board[row][col] != Empty {
+		return
+	}
+
+	s.board[row][col] = s.currentTurn
+	playSound(clickSound)
+	s.advanceTurn()
+}
+
+// updateAITurn waits out the move delay, then lets the AI play its mark.
+func (s *GameScene) updateAITurn() {
+	if s.aiDelay > 0 {
+		s.aiDelay--
+		return
+	}
+
+	aiMove(s.board, s.preset.Rows, s.preset.Cols, s.preset.K, s.currentTurn, s.difficulty)
+	playSound(clickSound)
+	s.advanceTurn()
+}
+
+// advanceTurn checks for a terminal state after a move, handing off to a ResultScene,
+// otherwise passes the turn to the other side.
+func (s *GameScene) advanceTurn() {
+	if checkWinFor(s.board, s.preset.Rows, s.preset.Cols, s.preset.K, s.currentTurn) {
+		result := ResultXWins
+		if s.currentTurn == O {
+			result = ResultOWins
+		}
+		s.finish(result)
+		return
+	}
+	if boardIsFull(s.board) {
+		s.finish(ResultDraw)
+		return
+	}
+
+	s.currentTurn = s.currentTurn.opponent()
+	if s.players[s.currentTurn] == AIPlayer {
+		s.aiDelay = 30 // Wait about half a second before AI moves
+	}
+}
+
+// finish records the outcome, persists stats, and hands off to a ResultScene.
+func (s *GameScene) finish(result ResultState) {
+	playSound(resultSound(result))
+	s.stats.Record(result, s.humanMark())
+	s.stats.Save()
+	s.manager.GoTo(NewResultScene(s.manager, s.stats, s.preset, s.difficulty, s.players, result))
+}
+
+// humanMark returns which mark the human is playing.
+func (s *GameScene) humanMark() Cell {
+	for mark, pt := range s.players {
+		if pt == HumanPlayer {
+			return mark
+		}
+	}
+	return X
+}
+
+func (s *GameScene) Draw(screen *ebiten.Image) {
+	screen.Fill(backgroundColor)
+
+	s.drawGrid(screen)
+	s.drawMarks(screen)
+
+	turn := "Apple's turn"
+	if s.currentTurn == O {
+		turn = "Pear's turn"
+	}
+	text.Draw(screen, turn, hudFace, 10, ScreenHeight-10, textColor)
+
+	hud := fmt.Sprintf("You %d · Pear %d · Draws %d", s.stats.Wins, s.stats.Losses, s.stats.Draws)
+	text.Draw(screen, hud, hudFace, 10, 15, textColor)
+
+	if muted {
+		text.Draw(screen, "M: unmute", hudFace, ScreenWidth-70, ScreenHeight-10, textColor)
+	} else {
+		text.Draw(screen, "M: mute", hudFace, ScreenWidth-70, ScreenHeight-10, textColor)
+	}
+}
+
+func (s *GameScene) drawGrid(screen *ebiten.Image) {
+	for i := 1; i < s.preset.Cols; i++ {
+		vector.StrokeLine(screen, float32(i*s.cellW), 0, float32(i*s.cellW), float32(ScreenHeight), 1, lineColor, false)
+	}
+	for i := 1; i < s.preset.Rows; i++ {
+		vector.StrokeLine(screen, 0, float32(i*s.cellH), float32(ScreenWidth), float32(i*s.cellH), 1, lineColor, false)
+	}
+}
+
+func (s *GameScene) drawMarks(screen *ebiten.Image) {
+	for row := 0; row < s.preset.Rows; row++ {
+		for col := 0; col < s.preset.Cols; col++ {
+			x := float64(col * s.cellW)
+			y := float64(row * s.cellH)
+
+			switch s.board[row][col] {
+			case X:
+				s.drawMark(screen, appleImage, x, y)
+			case O:
+				s.drawMark(screen, pearImage, x, y)
+			}
+		}
+	}
+}
+
+// drawMark draws img, scaled and centered, in the cell whose top-left corner is (x, y).
+func (s *GameScene) drawMark(screen, img *ebiten.Image, x, y float64) {
+	if img == nil {
+		return
+	}
+
+	cellSize := s.cellW
+	if s.cellH < cellSize {
+		cellSize = s.cellH
+	}
+	scale := float64(cellSize) * ImageScale / float64(img.Bounds().Dx())
+
+	op := &ebiten.DrawImageOptions{}
+	imgWidth, imgHeight := img.Bounds().Dx(), img.Bounds().Dy()
+	op.GeoM.Scale(scale, scale)
+	op.GeoM.Translate(
+		x+(float64(s.cellW)-float64(imgWidth)*scale)/2,
+		y+(float64(s.cellH)-float64(imgHeight)*scale)/2,
+	)
+	screen.DrawImage(img, op)
+}
+
+func (s *GameScene) Layout(outsideWidth, outsideHeight int) (int, int) {
+	return ScreenWidth, ScreenHeight
+}
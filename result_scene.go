@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// ResultState is the outcome of a finished match.
+type ResultState int
+
+const (
+	ResultXWins ResultState = iota
+	ResultOWins
+	ResultDraw
+)
+
+// resultSound returns the jingle to play for a terminal state.
+func resultSound(result ResultState) []byte {
+	switch result {
+	case ResultXWins:
+		return xWinsSound
+	case ResultOWins:
+		return oWinsSound
+	default:
+		return drawSound
+	}
+}
+
+// button is a clickable rectangle with a label, used for the result screen's actions.
+type button struct {
+	x, y, w, h int
+	label      string
+}
+
+func (b button) contains(px, py int) bool {
+	return px >= b.x && px < b.x+b.w && py >= b.y && py < b.y+b.h
+}
+
+// ResultScene shows the win/lose/draw banner and offers to retry with the same
+// settings or return to the title screen.
+type ResultScene struct {
+	manager *SceneManager
+	stats   *Stats
+
+	preset     BoardConfig
+	difficulty Difficulty
+	players    map[Cell]PlayerType
+	result     ResultState
+
+	retryButton button
+	menuButton  button
+}
+
+func NewResultScene(manager *SceneManager, stats *Stats, preset BoardConfig, difficulty Difficulty, players map[Cell]PlayerType, result ResultState) *ResultScene {
+	return &ResultScene{
+		manager:     manager,
+		stats:       stats,
+		preset:      preset,
+		difficulty:  difficulty,
+		players:     players,
+		result:      result,
+		retryButton: button{x: 150, y: 300, w: 120, h: 40, label: "Retry"},
+		menuButton:  button{x: 330, y: 300, w: 120, h: 40, label: "Main Menu"},
+	}
+}
+
+func (s *ResultScene) Update() error {
+	if !inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		return nil
+	}
+
+	x, y := ebiten.CursorPosition()
+	switch {
+	case s.retryButton.contains(x, y):
+		s.manager.GoTo(NewGameScene(s.manager, s.stats, s.preset, s.difficulty, s.players))
+	case s.menuButton.contains(x, y):
+		s.manager.GoTo(NewTitleScene(s.manager, s.stats))
+	}
+	return nil
+}
+
+func (s *ResultScene) Draw(screen *ebiten.Image) {
+	screen.Fill(backgroundColor)
+
+	var banner string
+	switch s.result {
+	case ResultXWins:
+		banner = "Apple wins!"
+	case ResultOWins:
+		banner = "Pear wins!"
+	case ResultDraw:
+		banner = "Draw!"
+	}
+	drawCentered(screen, banner, titleFace, ScreenWidth/2, 200, textColor)
+	drawCentered(screen, fmt.Sprintf("You %d · Pear %d · Draws %d", s.stats.Wins, s.stats.Losses, s.stats.Draws), hudFace, ScreenWidth/2, 230, textColor)
+	if glyphs := s.stats.RecentGlyphs(); glyphs != "" {
+		drawCentered(screen, "Recent: "+glyphs, hudFace, ScreenWidth/2, 250, textColor)
+	}
+
+	drawButton(screen, s.retryButton)
+	drawButton(screen, s.menuButton)
+}
+
+func drawButton(screen *ebiten.Image, b button) {
+	vector.StrokeRect(screen, float32(b.x), float32(b.y), float32(b.w), float32(b.h), 1, lineColor, false)
+	drawCentered(screen, b.label, cellFace, b.x+b.w/2, b.y+b.h/2+5, textColor)
+}
+
+func (s *ResultScene) Layout(outsideWidth, outsideHeight int) (int, int) {
+	return ScreenWidth, ScreenHeight
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// Scene is one self-contained screen: the title, a game in progress, or a result banner.
+type Scene interface {
+	Update() error
+	Draw(screen *ebiten.Image)
+	Layout(outsideWidth, outsideHeight int) (int, int)
+}
+
+// fadeFrames is how many ticks each half of a scene transition takes.
+const fadeFrames = 15
+
+// SceneManager owns the active Scene and implements ebiten.Game, so main only ever
+// runs a single SceneManager and scene changes happen by swapping the active Scene.
+// GoTo fades to black over fadeFrames ticks, swaps the scene, then fades back in.
+type SceneManager struct {
+	current Scene
+	next    Scene
+	fadeOut int
+	fadeIn  int
+}
+
+// GoTo starts a transition to scene. A transition already in flight is replaced.
+func (m *SceneManager) GoTo(scene Scene) {
+	m.next = scene
+	m.fadeOut = fadeFrames
+	m.fadeIn = 0
+}
+
+func (m *SceneManager) Update() error {
+	if m.fadeOut > 0 {
+		m.fadeOut--
+		if m.fadeOut == 0 {
+			m.current = m.next
+			m.next = nil
+			m.fadeIn = fadeFrames
+		}
+		return nil
+	}
+	if m.fadeIn > 0 {
+		m.fadeIn--
+		return nil
+	}
+	return m.current.Update()
+}
+
+func (m *SceneManager) Draw(screen *ebiten.Image) {
+	m.current.Draw(screen)
+
+	var alpha float64
+	switch {
+	case m.fadeOut > 0:
+		alpha = 1 - float64(m.fadeOut)/float64(fadeFrames)
+	case m.fadeIn > 0:
+		alpha = float64(m.fadeIn) / float64(fadeFrames)
+	}
+	if alpha > 0 {
+		vector.DrawFilledRect(screen, 0, 0, float32(ScreenWidth), float32(ScreenHeight), color.RGBA{0, 0, 0, uint8(alpha * 255)}, false)
+	}
+}
+
+func (m *SceneManager) Layout(outsideWidth, outsideHeight int) (int, int) {
+	return m.current.Layout(outsideWidth, outsideHeight)
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+)
+
+// maxRecentResults bounds how many past outcomes are kept in history.
+const maxRecentResults = 10
+
+// Stats tracks cumulative match outcomes and a bounded history of recent results,
+// persisted across sessions from the human player's perspective.
+type Stats struct {
+	Wins   int      `json:"wins"`
+	Losses int      `json:"losses"`
+	Draws  int      `json:"draws"`
+	Recent []string `json:"recent"`
+}
+
+// LoadStats reads persisted stats, returning a zero-value Stats if none exist yet.
+func LoadStats() *Stats {
+	data, err := readStatsData()
+	if err != nil {
+		return &Stats{}
+	}
+
+	var s Stats
+	if err := json.Unmarshal(data, &s); err != nil {
+		return &Stats{}
+	}
+	return &s
+}
+
+// Save persists the stats, logging but not failing the caller on error.
+func (s *Stats) Save() {
+	data, err := json.Marshal(s)
+	if err != nil {
+		log.Print(err)
+		return
+	}
+	if err := writeStatsData(data); err != nil {
+		log.Print(err)
+	}
+}
+
+// Record updates the counters and recent-results history for a finished match, judging
+// the outcome from humanMark's perspective since either mark may be human-controlled.
+func (s *Stats) Record(result ResultState, humanMark Cell) {
+	var outcome string
+	switch {
+	case result == ResultDraw:
+		s.Draws++
+		outcome = "draw"
+	case (result == ResultXWins) == (humanMark == X):
+		s.Wins++
+		outcome = "win"
+	default:
+		s.Losses++
+		outcome = "loss"
+	}
+
+	s.Recent = append(s.Recent, outcome)
+	if len(s.Recent) > maxRecentResults {
+		s.Recent = s.Recent[len(s.Recent)-maxRecentResults:]
+	}
+}
+
+// RecentGlyphs renders the bounded recent-results history as a compact win/loss/draw
+// streak, oldest first, for display on the title and result screens.
+func (s *Stats) RecentGlyphs() string {
+	glyphs := make([]byte, len(s.Recent))
+	for i, outcome := range s.Recent {
+		switch outcome {
+		case "win":
+			glyphs[i] = 'W'
+		case "loss":
+			glyphs[i] = 'L'
+		default:
+			glyphs[i] = 'D'
+		}
+	}
+	return string(glyphs)
+}
@@ -0,0 +1,38 @@
+//go:build !(js && wasm)
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+)
+
+const statsFileName = "apples-and-pears-stats.json"
+
+// statsFilePath resolves the per-user path stats are persisted to.
+func statsFilePath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, statsFileName), nil
+}
+
+func readStatsData() ([]byte, error) {
+	path, err := statsFilePath()
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(path)
+}
+
+func writeStatsData(data []byte) error {
+	path, err := statsFilePath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,24 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"errors"
+	"syscall/js"
+)
+
+// statsStorageKey is the localStorage key stats are persisted under in the browser.
+const statsStorageKey = "apples-and-pears-stats"
+
+func readStatsData() ([]byte, error) {
+	item := js.Global().Get("localStorage").Call("getItem", statsStorageKey)
+	if item.IsNull() {
+		return nil, errors.New("no stats saved")
+	}
+	return []byte(item.String()), nil
+}
+
+func writeStatsData(data []byte) error {
+	js.Global().Get("localStorage").Call("setItem", statsStorageKey, string(data))
+	return nil
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"image/color"
+	"io/fs"
+	"log"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+)
+
+// Font sizes, in points, for the three registers the UI needs: banners, HUD/body
+// text, and labels drawn inside board cells or buttons.
+const (
+	titleFontSize = 28
+	hudFontSize   = 14
+	cellFontSize  = 16
+)
+
+var (
+	titleFace font.Face
+	hudFace   font.Face
+	cellFace  font.Face
+)
+
+// initFonts loads the embedded TTF once and caches the sized faces used throughout the UI.
+func initFonts() {
+	data, err := fs.ReadFile(fontFS, "game.ttf")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	tt, err := opentype.Parse(data)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	titleFace = mustNewFace(tt, titleFontSize)
+	hudFace = mustNewFace(tt, hudFontSize)
+	cellFace = mustNewFace(tt, cellFontSize)
+}
+
+func mustNewFace(tt *opentype.Font, size float64) font.Face {
+	face, err := opentype.NewFace(tt, &opentype.FaceOptions{
+		Size:    size,
+		DPI:     72,
+		Hinting: font.HintingFull,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	return face
+}
+
+// drawCentered draws s horizontally centered on cx, with its baseline at y.
+func drawCentered(screen *ebiten.Image, s string, face font.Face, cx, y int, clr color.Color) {
+	width := font.MeasureString(face, s).Ceil()
+	text.Draw(screen, s, face, cx-width/2, y, clr)
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+)
+
+// TitleScene shows the logo, then a board-size picker, a human-side picker, and a
+// difficulty picker, before handing off to a new GameScene.
+type TitleScene struct {
+	manager *SceneManager
+	stats   *Stats
+
+	boardPicked bool
+	boardIndex  int
+
+	sidePicked bool
+	humanMark  Cell
+}
+
+func NewTitleScene(manager *SceneManager, stats *Stats) *TitleScene {
+	return &TitleScene{manager: manager, stats: stats}
+}
+
+func (s *TitleScene) Update() error {
+	if !s.boardPicked {
+		for i := range boardPresets {
+			if inpututil.IsKeyJustPressed(ebiten.Key(int(ebiten.Key1) + i)) {
+				s.boardIndex = i
+				s.boardPicked = true
+				return nil
+			}
+		}
+		return nil
+	}
+
+	if !s.sidePicked {
+		if inpututil.IsKeyJustPressed(ebiten.Key1) {
+			s.humanMark = X
+			s.sidePicked = true
+		} else if inpututil.IsKeyJustPressed(ebiten.Key2) {
+			s.humanMark = O
+			s.sidePicked = true
+		}
+		return nil
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.Key1) {
+		s.startGame(Easy)
+	} else if inpututil.IsKeyJustPressed(ebiten.Key2) {
+		s.startGame(Medium)
+	} else if inpututil.IsKeyJustPressed(ebiten.Key3) {
+		s.startGame(Hard)
+	}
+	return nil
+}
+
+func (s *TitleScene) startGame(difficulty Difficulty) {
+	players := map[Cell]PlayerType{
+		s.humanMark:            HumanPlayer,
+		s.humanMark.opponent(): AIPlayer,
+	}
+	s.manager.GoTo(NewGameScene(s.manager, s.stats, boardPresets[s.boardIndex], difficulty, players))
+}
+
+func (s *TitleScene) Draw(screen *ebiten.Image) {
+	screen.Fill(backgroundColor)
+	drawCentered(screen, "Apples and Pears", titleFace, ScreenWidth/2, 60, textColor)
+	drawCentered(screen, fmt.Sprintf("You %d · Pear %d · Draws %d", s.stats.Wins, s.stats.Losses, s.stats.Draws), hudFace, ScreenWidth/2, 90, textColor)
+	if glyphs := s.stats.RecentGlyphs(); glyphs != "" {
+		drawCentered(screen, "Recent: "+glyphs, hudFace, ScreenWidth/2, 110, textColor)
+	}
+
+	if !s.boardPicked {
+		y := 140
+		for i, preset := range boardPresets {
+			text.Draw(screen, string(rune('1'+i))+": "+preset.Name, hudFace, 10, y+i*24, textColor)
+		}
+		return
+	}
+
+	if !s.sidePicked {
+		text.Draw(screen, "Play as: 1 Apple  2 Pear", hudFace, 10, 140, textColor)
+		return
+	}
+
+	text.Draw(screen, "Choose difficulty: 1 Easy  2 Medium  3 Hard", hudFace, 10, 140, textColor)
+}
+
+func (s *TitleScene) Layout(outsideWidth, outsideHeight int) (int, int) {
+	return ScreenWidth, ScreenHeight
+}